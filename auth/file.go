@@ -0,0 +1,135 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth provides ready-made http.AuthProvider implementations for
+// common ways of supplying rotating authentication headers.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// File is an http.AuthProvider that reads a JSON object of header name to
+// header value from a file, re-reading it whenever the file changes on
+// disk so that credentials can be rotated without restarting the process.
+type File struct {
+	log     zerolog.Logger
+	path    string
+	watcher *fsnotify.Watcher
+
+	mu      sync.RWMutex
+	headers map[string]string
+}
+
+// NewFile creates an http.AuthProvider that watches the file at path and
+// serves its contents as headers.
+func NewFile(ctx context.Context, path string) (*File, error) {
+	if path == "" {
+		return nil, errors.New("no path supplied")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to create file watcher"), err)
+	}
+
+	if err := watcher.Add(path); err != nil {
+		watcher.Close()
+
+		return nil, errors.Join(errors.New("failed to watch auth headers file"), err)
+	}
+
+	f := &File{
+		log:     zerologger.With().Str("service", "auth").Str("impl", "file").Logger(),
+		path:    path,
+		watcher: watcher,
+	}
+
+	if err := f.reload(); err != nil {
+		watcher.Close()
+
+		return nil, err
+	}
+
+	go f.watch(ctx)
+
+	return f, nil
+}
+
+// Headers provides the most recently loaded set of headers.
+func (f *File) Headers(_ context.Context) (map[string]string, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	headers := make(map[string]string, len(f.headers))
+	for k, v := range f.headers {
+		headers[k] = v
+	}
+
+	return headers, nil
+}
+
+// reload reads and parses the auth headers file.
+func (f *File) reload() error {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return errors.Join(errors.New("failed to read auth headers file"), err)
+	}
+
+	var headers map[string]string
+	if err := json.Unmarshal(data, &headers); err != nil {
+		return errors.Join(errors.New("failed to parse auth headers file"), err)
+	}
+
+	f.mu.Lock()
+	f.headers = headers
+	f.mu.Unlock()
+
+	return nil
+}
+
+// watch reloads the auth headers file whenever it changes, until ctx is
+// done.
+func (f *File) watch(ctx context.Context) {
+	defer f.watcher.Close()
+
+	for {
+		select {
+		case event, ok := <-f.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			if err := f.reload(); err != nil {
+				f.log.Warn().Err(err).Msg("Failed to reload auth headers file")
+			}
+		case err, ok := <-f.watcher.Errors:
+			if !ok {
+				return
+			}
+			f.log.Warn().Err(err).Msg("Error watching auth headers file")
+		case <-ctx.Done():
+			return
+		}
+	}
+}
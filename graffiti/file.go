@@ -0,0 +1,66 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graffiti
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// File is a GraffitiProvider that reads its graffiti from a file, re-reading
+// the file's contents on every call so that it can be updated without
+// restarting the process.
+type File struct {
+	path string
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// NewFile creates a GraffitiProvider that reads graffiti from the file at
+// the given path each time it is called.
+func NewFile(path string) (*File, error) {
+	if path == "" {
+		return nil, errors.New("no path supplied")
+	}
+
+	return &File{path: path}, nil
+}
+
+// Graffiti reads the graffiti file and returns its contents, truncated to
+// fit the 32-byte field.
+func (f *File) Graffiti(_ context.Context, _ phase0.Slot, _ phase0.ValidatorIndex) ([32]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var graffiti [32]byte
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		f.lastErr = err
+
+		return graffiti, errors.Join(errors.New("failed to read graffiti file"), err)
+	}
+	f.lastErr = nil
+
+	data = bytes.TrimSpace(data)
+	copy(graffiti[:], data)
+
+	return graffiti, nil
+}
@@ -0,0 +1,51 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graffiti
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// RoundRobin is a GraffitiProvider that cycles through a fixed list of
+// graffiti, one per call.
+type RoundRobin struct {
+	mu       sync.Mutex
+	graffiti [][32]byte
+	next     int
+}
+
+// NewRoundRobin creates a GraffitiProvider that returns each of the supplied
+// graffiti in turn, wrapping back to the start once exhausted.
+func NewRoundRobin(graffiti [][32]byte) (*RoundRobin, error) {
+	if len(graffiti) == 0 {
+		return nil, errors.New("no graffiti supplied")
+	}
+
+	return &RoundRobin{graffiti: graffiti}, nil
+}
+
+// Graffiti provides the next graffiti in the list.
+func (r *RoundRobin) Graffiti(_ context.Context, _ phase0.Slot, _ phase0.ValidatorIndex) ([32]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	graffiti := r.graffiti[r.next]
+	r.next = (r.next + 1) % len(r.graffiti)
+
+	return graffiti, nil
+}
@@ -0,0 +1,44 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graffiti_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/graffiti"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoundRobinNoGraffiti(t *testing.T) {
+	_, err := graffiti.NewRoundRobin(nil)
+	require.ErrorContains(t, err, "no graffiti supplied")
+}
+
+func TestRoundRobinCycles(t *testing.T) {
+	ctx := context.Background()
+
+	g1 := [32]byte{0x01}
+	g2 := [32]byte{0x02}
+	g3 := [32]byte{0x03}
+
+	provider, err := graffiti.NewRoundRobin([][32]byte{g1, g2, g3})
+	require.NoError(t, err)
+
+	for _, expected := range [][32]byte{g1, g2, g3, g1, g2} {
+		actual, err := provider.Graffiti(ctx, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graffiti provides a handful of ready-made http.GraffitiProvider
+// implementations for common ways of supplying proposal graffiti.
+package graffiti
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Static is a GraffitiProvider that always returns the same graffiti.
+type Static struct {
+	graffiti [32]byte
+}
+
+// NewStatic creates a GraffitiProvider that always returns the supplied
+// graffiti.
+func NewStatic(graffiti [32]byte) *Static {
+	return &Static{graffiti: graffiti}
+}
+
+// Graffiti provides the static graffiti.
+func (s *Static) Graffiti(_ context.Context, _ phase0.Slot, _ phase0.ValidatorIndex) ([32]byte, error) {
+	return s.graffiti, nil
+}
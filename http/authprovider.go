@@ -0,0 +1,114 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// AuthProvider supplies HTTP headers used to authenticate with the beacon
+// node, for credentials that can rotate while the process is running (for
+// example tokens issued by Vault or AWS Secrets Manager). It is consulted
+// on each request, subject to a short cache, and merged on top of any
+// static extraHeaders, with the provider's values winning on collision.
+type AuthProvider interface {
+	Headers(ctx context.Context) (map[string]string, error)
+}
+
+// authHeaderCacheTTL bounds how often the configured AuthProvider is
+// actually queried; requests within the TTL reuse the cached header set.
+const authHeaderCacheTTL = 5 * time.Second
+
+// authHeaders merges the service's static extraHeaders with the headers
+// supplied by its AuthProvider (if any), refreshing the provider's headers
+// at most once per authHeaderCacheTTL.
+func (s *Service) authHeaders(ctx context.Context) (map[string]string, error) {
+	if s.authProvider == nil {
+		return s.extraHeaders, nil
+	}
+
+	s.authHeadersMu.RLock()
+	fresh := time.Since(s.authHeadersCachedAt) < authHeaderCacheTTL
+	cached := s.authHeadersMerged
+	s.authHeadersMu.RUnlock()
+
+	if fresh {
+		return cached, nil
+	}
+
+	provided, err := s.authProvider.Headers(ctx)
+	if err != nil {
+		// Fall back to whatever headers last worked (or, failing that, the
+		// static extraHeaders) rather than failing every request while the
+		// AuthProvider is transiently unavailable.
+		s.log.Warn().Err(err).Msg("Failed to obtain auth headers; reusing previous headers")
+
+		if cached != nil {
+			return cached, nil
+		}
+
+		return s.extraHeaders, nil
+	}
+
+	merged := make(map[string]string, len(s.extraHeaders)+len(provided))
+	for k, v := range s.extraHeaders {
+		merged[k] = v
+	}
+	for k, v := range provided {
+		merged[k] = v
+	}
+
+	s.authHeadersMu.Lock()
+	changed := headersDiffer(s.authHeadersMerged, merged)
+	s.authHeadersMerged = merged
+	s.authHeadersCachedAt = time.Now()
+	s.authHeadersMu.Unlock()
+
+	if changed {
+		s.log.Info().Strs("keys", headerKeys(provided)).Msg("Auth headers rotated")
+		s.monitorAuthHeaderRotation()
+	}
+
+	return merged, nil
+}
+
+// headersDiffer returns true if the header sets a and b differ, by key or
+// by value, so that a credential rotating its value under an unchanged key
+// (the common case for a renewed bearer token) is still detected as a
+// change. Only the keys are ever logged or otherwise surfaced, so the
+// values compared here never leak credential material.
+func headersDiffer(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return true
+	}
+	for k, v := range a {
+		if bv, exists := b[k]; !exists || bv != v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func headerKeys(headers map[string]string) []string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
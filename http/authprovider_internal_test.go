@@ -0,0 +1,106 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadersDiffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        map[string]string
+		b        map[string]string
+		expected bool
+	}{
+		{
+			name:     "Identical",
+			a:        map[string]string{"Authorization": "one"},
+			b:        map[string]string{"Authorization": "one"},
+			expected: false,
+		},
+		{
+			name:     "SameKeysDifferentValues",
+			a:        map[string]string{"Authorization": "one"},
+			b:        map[string]string{"Authorization": "two"},
+			expected: true,
+		},
+		{
+			name:     "DifferentLength",
+			a:        map[string]string{"Authorization": "one"},
+			b:        map[string]string{"Authorization": "one", "X-Extra": "two"},
+			expected: true,
+		},
+		{
+			name:     "DifferentKeys",
+			a:        map[string]string{"Authorization": "one"},
+			b:        map[string]string{"X-Extra": "one"},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, headersDiffer(test.a, test.b))
+		})
+	}
+}
+
+func TestHeaderKeysSorted(t *testing.T) {
+	keys := headerKeys(map[string]string{"X-B": "1", "X-A": "2"})
+	require.Equal(t, []string{"X-A", "X-B"}, keys)
+}
+
+// fakeAuthProvider is an AuthProvider whose Headers() returns a fixed set,
+// or fails if err is set.
+type fakeAuthProvider struct {
+	headers map[string]string
+	err     error
+}
+
+func (f *fakeAuthProvider) Headers(_ context.Context) (map[string]string, error) {
+	return f.headers, f.err
+}
+
+func TestAuthHeadersMergePrecedence(t *testing.T) {
+	provider := &fakeAuthProvider{headers: map[string]string{"Authorization": "rotating"}}
+	s := &Service{
+		log:          zerolog.Nop(),
+		extraHeaders: map[string]string{"Authorization": "static", "X-Static": "kept"},
+		authProvider: provider,
+	}
+
+	headers, err := s.authHeaders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "rotating", headers["Authorization"])
+	require.Equal(t, "kept", headers["X-Static"])
+}
+
+func TestAuthHeadersFallBackOnProviderError(t *testing.T) {
+	provider := &fakeAuthProvider{err: errors.New("provider unavailable")}
+	s := &Service{
+		log:          zerolog.Nop(),
+		extraHeaders: map[string]string{"Authorization": "static"},
+		authProvider: provider,
+	}
+
+	headers, err := s.authHeaders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "static", headers["Authorization"])
+}
@@ -0,0 +1,92 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BlindedProposal fetches a blinded proposal for signing, filling in
+// graffiti from the configured GraffitiProvider if the caller left it
+// unset.
+func (s *Service) BlindedProposal(ctx context.Context, opts *api.BlindedProposalOpts) (*api.Response[*api.VersionedBlindedProposal], error) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if opts.Slot == 0 {
+		return nil, errors.New("no slot specified")
+	}
+	if opts.SkipRandaoVerification && !isPointAtInfinity(opts.RandaoReveal) {
+		return nil, errors.New("randao reveal must be point at infinity if skip randao verification is set")
+	}
+
+	// The proposal endpoint is addressed by slot alone, so the
+	// GraffitiProvider is consulted without a validator index.
+	graffiti, err := s.applyGraffiti(ctx, opts.Slot, 0, opts.Graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("randao_reveal", fmt.Sprintf("%#x", opts.RandaoReveal))
+	if graffiti != ([32]byte{}) {
+		query.Set("graffiti", fmt.Sprintf("%#x", graffiti))
+	}
+	if opts.SkipRandaoVerification {
+		query.Set("skip_randao_verification", "")
+	}
+
+	endpoint := fmt.Sprintf("eth/v1/validator/blinded_blocks/%d?%s", opts.Slot, query.Encode())
+
+	httpResponse, err := s.submitRequest(ctx, requestOpts{
+		method:   http.MethodGet,
+		endpoint: endpoint,
+		route:    "eth/v1/validator/blinded_blocks/{slot}",
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain blinded proposal"), err)
+	}
+	defer httpResponse.Body.Close()
+
+	var proposal api.VersionedBlindedProposal
+	if err := json.NewDecoder(httpResponse.Body).Decode(&proposal); err != nil {
+		return nil, errors.Join(errors.New("failed to parse blinded proposal"), err)
+	}
+
+	return &api.Response[*api.VersionedBlindedProposal]{Data: &proposal}, nil
+}
+
+// isPointAtInfinity returns true if sig is the BLS point at infinity, the
+// signature used to request a blinded proposal without the caller having
+// produced a real RANDAO reveal.
+func isPointAtInfinity(sig phase0.BLSSignature) bool {
+	if sig[0] != 0xc0 {
+		return false
+	}
+	for _, b := range sig[1:] {
+		if b != 0x00 {
+			return false
+		}
+	}
+
+	return true
+}
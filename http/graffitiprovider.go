@@ -0,0 +1,75 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// GraffitiProvider provides graffiti to include in a block or blinded block
+// proposal. It is consulted whenever a caller leaves the Graffiti field of
+// their proposal opts unset.
+type GraffitiProvider interface {
+	// Graffiti provides the graffiti to use for a proposal at the given
+	// slot, by the given validator index. Proposal and BlindedProposal in
+	// this package address proposals by slot alone and always pass a
+	// validator index of 0.
+	Graffiti(ctx context.Context, slot phase0.Slot, validatorIndex phase0.ValidatorIndex) ([32]byte, error)
+}
+
+// applyGraffiti fills in graffiti from the service's configured
+// GraffitiProvider if the supplied value is unset, sanitising the
+// provider's graffiti to fit in the 32-byte field. Graffiti supplied by
+// the caller is returned as given, without sanitisation.
+func (s *Service) applyGraffiti(ctx context.Context,
+	slot phase0.Slot,
+	validatorIndex phase0.ValidatorIndex,
+	graffiti [32]byte,
+) ([32]byte, error) {
+	if graffiti != ([32]byte{}) || s.graffitiProvider == nil {
+		return graffiti, nil
+	}
+
+	provided, err := s.graffitiProvider.Graffiti(ctx, slot, validatorIndex)
+	if err != nil {
+		return graffiti, errors.Join(errors.New("failed to obtain graffiti"), err)
+	}
+
+	return sanitiseGraffiti(provided), nil
+}
+
+// sanitiseGraffiti truncates graffiti to valid, printable ASCII and ensures
+// it fits within the fixed-size 32-byte field, padding with zero bytes.
+func sanitiseGraffiti(graffiti [32]byte) [32]byte {
+	var sanitised [32]byte
+
+	n := 0
+	for _, b := range graffiti {
+		if b == 0 {
+			break
+		}
+		if b < 0x20 || b > 0x7e {
+			// Not printable ASCII; stop here rather than include bytes
+			// that could confuse downstream consumers.
+			break
+		}
+		sanitised[n] = b
+		n++
+	}
+
+	return sanitised
+}
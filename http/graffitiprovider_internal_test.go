@@ -0,0 +1,50 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitiseGraffiti(t *testing.T) {
+	tests := []struct {
+		name     string
+		graffiti [32]byte
+		expected [32]byte
+	}{
+		{
+			name:     "Empty",
+			graffiti: [32]byte{},
+			expected: [32]byte{},
+		},
+		{
+			name:     "Printable",
+			graffiti: [32]byte{'h', 'e', 'l', 'l', 'o'},
+			expected: [32]byte{'h', 'e', 'l', 'l', 'o'},
+		},
+		{
+			name:     "NonPrintableTruncated",
+			graffiti: [32]byte{'h', 'i', 0x01, 'x'},
+			expected: [32]byte{'h', 'i'},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, sanitiseGraffiti(test.graffiti))
+		})
+	}
+}
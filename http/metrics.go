@@ -0,0 +1,251 @@
+// Copyright © 2020 - 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// serviceMetrics holds the Prometheus collectors used by a single Service
+// instance. Keeping them on the Service, rather than in package-level
+// variables, means two Service instances registered against different
+// registerers (via WithPrometheusRegisterer) each observe into their own
+// collectors instead of the second instance's registration silently
+// overwriting the first's.
+type serviceMetrics struct {
+	requestDuration *prometheus.HistogramVec
+	requestOutcome  *prometheus.CounterVec
+	responseFormat  *prometheus.CounterVec
+	staticRefreshes *prometheus.CounterVec
+	dvtMiddleware   *prometheus.GaugeVec
+	lastPingAge     *prometheus.GaugeVec
+	authRotations   *prometheus.CounterVec
+	connectionState *prometheus.GaugeVec
+}
+
+// registerMetrics registers the Prometheus collectors used by a Service
+// against the registerer supplied via WithPrometheusRegisterer (or the
+// default registerer if none was supplied), returning nil if monitor is
+// nil. Registering against a shared registerer is idempotent: if another
+// Service instance sharing that registerer has already registered these
+// collectors, the existing ones are reused rather than causing a
+// duplicate-registration panic.
+func registerMetrics(_ context.Context, monitor metrics.Service, registerer prometheus.Registerer) (*serviceMetrics, error) {
+	if monitor == nil {
+		return nil, nil
+	}
+
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	m := &serviceMetrics{}
+
+	m.requestDuration = registerOrReuseHistogramVec(registerer, prometheus.HistogramOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of requests made to the beacon node, in seconds.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{"route", "method"})
+
+	m.requestOutcome = registerOrReuseCounterVec(registerer, prometheus.CounterOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of requests made to the beacon node, by outcome.",
+	}, []string{"route", "method", "status", "active", "synced"})
+
+	m.responseFormat = registerOrReuseCounterVec(registerer, prometheus.CounterOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "response_format_total",
+		Help:      "Number of responses received, by whether they were SSZ or JSON encoded.",
+	}, []string{"format"})
+
+	m.staticRefreshes = registerOrReuseCounterVec(registerer, prometheus.CounterOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "static_value_refreshes_total",
+		Help:      "Number of times cached static values (genesis, spec, fork schedule, etc.) were refreshed.",
+	}, []string{"value"})
+
+	m.dvtMiddleware = registerOrReuseGaugeVec(registerer, prometheus.GaugeOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "middleware_detected",
+		Help:      "Set to 1 for the middleware type currently detected for this connection, otherwise 0.",
+	}, []string{"address", "middleware"})
+
+	m.lastPingAge = registerOrReuseGaugeVec(registerer, prometheus.GaugeOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "last_successful_ping_age_seconds",
+		Help:      "Time since the last successful ping to the beacon node, in seconds.",
+	}, []string{"address"})
+
+	m.authRotations = registerOrReuseCounterVec(registerer, prometheus.CounterOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "auth_header_rotations_total",
+		Help:      "Number of times the configured AuthProvider's header set changed.",
+	}, []string{"address"})
+
+	m.connectionState = registerOrReuseGaugeVec(registerer, prometheus.GaugeOpts{
+		Namespace: "client",
+		Subsystem: "http",
+		Name:      "connection_synced",
+		Help:      "Set to 1 if the connection is synced, otherwise 0.",
+	}, []string{"address"})
+
+	return m, nil
+}
+
+func registerOrReuseHistogramVec(registerer prometheus.Registerer, opts prometheus.HistogramOpts, labels []string) *prometheus.HistogramVec {
+	vec := prometheus.NewHistogramVec(opts, labels)
+	if err := registerer.Register(vec); err != nil {
+		if are, isAre := err.(prometheus.AlreadyRegisteredError); isAre {
+			return are.ExistingCollector.(*prometheus.HistogramVec)
+		}
+	}
+
+	return vec
+}
+
+func registerOrReuseCounterVec(registerer prometheus.Registerer, opts prometheus.CounterOpts, labels []string) *prometheus.CounterVec {
+	vec := prometheus.NewCounterVec(opts, labels)
+	if err := registerer.Register(vec); err != nil {
+		if are, isAre := err.(prometheus.AlreadyRegisteredError); isAre {
+			return are.ExistingCollector.(*prometheus.CounterVec)
+		}
+	}
+
+	return vec
+}
+
+func registerOrReuseGaugeVec(registerer prometheus.Registerer, opts prometheus.GaugeOpts, labels []string) *prometheus.GaugeVec {
+	vec := prometheus.NewGaugeVec(opts, labels)
+	if err := registerer.Register(vec); err != nil {
+		if are, isAre := err.(prometheus.AlreadyRegisteredError); isAre {
+			return are.ExistingCollector.(*prometheus.GaugeVec)
+		}
+	}
+
+	return vec
+}
+
+// monitorActive sets the lastPingAge gauge to the time since the last
+// successful ping when the connection is active, or is a no-op if metrics
+// are not registered. Nothing is recorded when the connection is inactive.
+func (s *Service) monitorActive(active bool) {
+	if s.metrics == nil {
+		return
+	}
+
+	if active {
+		s.connectionMu.RLock()
+		lastPing := s.lastSuccessfulPing
+		s.connectionMu.RUnlock()
+
+		s.metrics.lastPingAge.WithLabelValues(s.address).Set(time.Since(lastPing).Seconds())
+	}
+}
+
+// monitorSynced sets the connectionState gauge to reflect whether the
+// connection is synced.
+func (s *Service) monitorSynced(synced bool) {
+	if s.metrics == nil {
+		return
+	}
+
+	value := 0.0
+	if synced {
+		value = 1.0
+	}
+	s.metrics.connectionState.WithLabelValues(s.address).Set(value)
+}
+
+// monitorRequest records the duration and outcome of a single HTTP call
+// made to the beacon node. It is called by submitRequest, the shared
+// request path used by every HTTP provider method in this package. route
+// is a templated endpoint path (e.g. "eth/v1/validator/blinded_blocks/{slot}")
+// rather than the interpolated URL, so the label stays low-cardinality.
+func (s *Service) monitorRequest(route string, method string, duration time.Duration, statusClass string) {
+	if s.metrics == nil {
+		return
+	}
+
+	s.metrics.requestDuration.WithLabelValues(route, method).Observe(duration.Seconds())
+
+	active := "false"
+	if s.IsActive(context.Background()) {
+		active = "true"
+	}
+	synced := "false"
+	if s.IsSynced(context.Background()) {
+		synced = "true"
+	}
+
+	s.metrics.requestOutcome.WithLabelValues(route, method, statusClass, active, synced).Inc()
+}
+
+// monitorResponseFormat records whether a response was served as SSZ or
+// JSON, so operators can see the impact of enforceJSON.
+func (s *Service) monitorResponseFormat(ssz bool) {
+	if s.metrics == nil {
+		return
+	}
+
+	format := "json"
+	if ssz {
+		format = "ssz"
+	}
+	s.metrics.responseFormat.WithLabelValues(format).Inc()
+}
+
+// monitorStaticRefresh records that a cached static value was refreshed.
+func (s *Service) monitorStaticRefresh(value string) {
+	if s.metrics == nil {
+		return
+	}
+
+	s.metrics.staticRefreshes.WithLabelValues(value).Inc()
+}
+
+// monitorAuthHeaderRotation records that the AuthProvider's header set has
+// changed since it was last queried.
+func (s *Service) monitorAuthHeaderRotation() {
+	if s.metrics == nil {
+		return
+	}
+
+	s.metrics.authRotations.WithLabelValues(s.address).Inc()
+}
+
+// monitorMiddleware records a transition in detected middleware, clearing
+// the gauge for the previous middleware and setting it for the new one.
+func (s *Service) monitorMiddleware(previous, current Middleware) {
+	if s.metrics == nil {
+		return
+	}
+
+	if previous != current {
+		s.metrics.dvtMiddleware.WithLabelValues(s.address, previous.String()).Set(0)
+	}
+	s.metrics.dvtMiddleware.WithLabelValues(s.address, current.String()).Set(1)
+}
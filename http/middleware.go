@@ -0,0 +1,164 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Middleware identifies a known piece of middleware sitting between this
+// client and the beacon node it ultimately talks to.
+type Middleware int
+
+const (
+	// MiddlewareNone means no middleware was detected; this client is
+	// talking directly to a beacon node.
+	MiddlewareNone Middleware = iota
+	// MiddlewareUnknown means a detector recognised that middleware of
+	// some kind is present, but could not identify which.
+	MiddlewareUnknown
+	// MiddlewareCharon is Obol's Charon DVT middleware.
+	MiddlewareCharon
+	// MiddlewareSSV is SSV Network's DVT middleware.
+	MiddlewareSSV
+	// MiddlewareMEVBoost is an mev-boost relay fronting a beacon API.
+	MiddlewareMEVBoost
+)
+
+// String provides a human-readable name for the middleware.
+func (m Middleware) String() string {
+	switch m {
+	case MiddlewareNone:
+		return "none"
+	case MiddlewareCharon:
+		return "charon"
+	case MiddlewareSSV:
+		return "ssv"
+	case MiddlewareMEVBoost:
+		return "mev-boost"
+	case MiddlewareUnknown:
+		return "unknown"
+	default:
+		return "unknown"
+	}
+}
+
+// Capability describes something known about how a piece of middleware
+// behaves, beyond simply which middleware it is.
+type Capability string
+
+const (
+	// CapabilitySupportsBlindedBlocks means the middleware supports
+	// blinded block proposals.
+	CapabilitySupportsBlindedBlocks Capability = "supports-blinded-blocks"
+	// CapabilityRequiresJSON means the middleware does not support SSZ
+	// request/response bodies and JSON must be used instead.
+	CapabilityRequiresJSON Capability = "requires-json"
+	// CapabilityFlattensAttestationAggregation means the middleware
+	// flattens attestation aggregation itself, so this client should not
+	// attempt to aggregate on top of it.
+	CapabilityFlattensAttestationAggregation Capability = "flattens-attestation-aggregation"
+)
+
+// MiddlewareDetector identifies middleware from a node's self-reported
+// version string. Additional detectors can be registered with
+// WithMiddlewareDetector so that private middleware stacks can self-identify
+// without patching this package.
+type MiddlewareDetector interface {
+	// Detect examines nodeVersion and, if it recognises it, returns the
+	// middleware and its capabilities with ok set to true. If the
+	// detector does not recognise the version string it returns ok false.
+	Detect(ctx context.Context, nodeVersion string) (middleware Middleware, capabilities map[Capability]bool, ok bool)
+}
+
+// charonDetector recognises Obol's Charon DVT middleware, which reports
+// itself in the node version string.
+type charonDetector struct{}
+
+func (charonDetector) Detect(_ context.Context, nodeVersion string) (Middleware, map[Capability]bool, bool) {
+	if !strings.Contains(strings.ToLower(nodeVersion), "charon") {
+		return MiddlewareNone, nil, false
+	}
+
+	return MiddlewareCharon, map[Capability]bool{
+		CapabilitySupportsBlindedBlocks: true,
+	}, true
+}
+
+// middlewareState holds the detected middleware and its capabilities.
+type middlewareState struct {
+	mu           sync.RWMutex
+	middleware   Middleware
+	capabilities map[Capability]bool
+}
+
+// Middleware returns the middleware detected for this connection, or
+// MiddlewareNone if no middleware has been detected (or detection has not
+// yet run).
+func (s *Service) Middleware() Middleware {
+	s.middlewareState.mu.RLock()
+	defer s.middlewareState.mu.RUnlock()
+
+	return s.middlewareState.middleware
+}
+
+// MiddlewareCapabilities returns the capability set reported for the
+// detected middleware. It returns an empty, non-nil map if no middleware
+// has been detected.
+func (s *Service) MiddlewareCapabilities() map[Capability]bool {
+	s.middlewareState.mu.RLock()
+	defer s.middlewareState.mu.RUnlock()
+
+	capabilities := make(map[Capability]bool, len(s.middlewareState.capabilities))
+	for k, v := range s.middlewareState.capabilities {
+		capabilities[k] = v
+	}
+
+	return capabilities
+}
+
+// detectMiddleware runs the configured detectors against nodeVersion in
+// order, stopping at the first that recognises it, and updates the
+// service's middleware state accordingly. If a recognised middleware is
+// known not to support SSZ, enforceJSON is flipped on automatically.
+func (s *Service) detectMiddleware(ctx context.Context, nodeVersion string) {
+	middleware := MiddlewareNone
+	var capabilities map[Capability]bool
+
+	for _, detector := range s.middlewareDetectors {
+		if detected, caps, ok := detector.Detect(ctx, nodeVersion); ok {
+			middleware = detected
+			capabilities = caps
+
+			break
+		}
+	}
+
+	previous := s.Middleware()
+
+	s.middlewareState.mu.Lock()
+	s.middlewareState.middleware = middleware
+	s.middlewareState.capabilities = capabilities
+	s.middlewareState.mu.Unlock()
+
+	if capabilities[CapabilityRequiresJSON] {
+		s.connectionMu.Lock()
+		s.enforceJSON = true
+		s.connectionMu.Unlock()
+	}
+
+	s.monitorMiddleware(previous, middleware)
+}
@@ -0,0 +1,182 @@
+// Copyright © 2020 - 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-eth2-client/signer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel             zerolog.Level
+	monitor              metrics.Service
+	address              string
+	timeout              time.Duration
+	allowDelayedStart    bool
+	extraHeaders         map[string]string
+	enforceJSON          bool
+	indexChunkSize       int
+	pubKeyChunkSize      int
+	graffitiProvider     GraffitiProvider
+	signer               signer.Service
+	prometheusRegisterer prometheus.Registerer
+	authProvider         AuthProvider
+	middlewareDetectors  []MiddlewareDetector
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithAddress sets the address for the beacon node connection.
+func WithAddress(address string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.address = address
+	})
+}
+
+// WithTimeout sets the initial timeout for the beacon node connection.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithAllowDelayedStart allows the creation of the service to continue even if
+// the beacon node is not contactable at present.
+func WithAllowDelayedStart(allowDelayedStart bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.allowDelayedStart = allowDelayedStart
+	})
+}
+
+// WithExtraHeaders sets additional headers to be sent with each request.
+func WithExtraHeaders(headers map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.extraHeaders = headers
+	})
+}
+
+// WithEnforceJSON forces the use of JSON (rather than SSZ) for beacon node connections.
+func WithEnforceJSON(enforceJSON bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.enforceJSON = enforceJSON
+	})
+}
+
+// WithIndexChunkSize sets the maximum number of validator indices to send in any single request.
+func WithIndexChunkSize(indexChunkSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.indexChunkSize = indexChunkSize
+	})
+}
+
+// WithPubKeyChunkSize sets the maximum number of validator public keys to send in any single request.
+func WithPubKeyChunkSize(pubKeyChunkSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pubKeyChunkSize = pubKeyChunkSize
+	})
+}
+
+// WithGraffitiProvider sets the graffiti provider used to fill in proposal
+// graffiti when the caller does not supply one.
+func WithGraffitiProvider(provider GraffitiProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.graffitiProvider = provider
+	})
+}
+
+// WithSigner sets the remote signer used to produce RANDAO reveals and
+// proposal/attestation signatures without the caller holding keys.
+func WithSigner(signerSvc signer.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signer = signerSvc
+	})
+}
+
+// WithPrometheusRegisterer sets the registerer used to register this
+// service's Prometheus collectors. Supply a dedicated registerer (rather
+// than relying on the default) when running multiple Service instances in
+// the same process, so their metrics don't collide.
+func WithPrometheusRegisterer(registerer prometheus.Registerer) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.prometheusRegisterer = registerer
+	})
+}
+
+// WithAuthProvider sets a provider of headers that can rotate at runtime
+// (for example bearer tokens or HMAC credentials issued by a secrets
+// manager), merged on top of any static WithExtraHeaders on each request.
+func WithAuthProvider(provider AuthProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.authProvider = provider
+	})
+}
+
+// WithMiddlewareDetector registers an additional MiddlewareDetector,
+// consulted after the built-in detectors, so that private middleware
+// stacks can self-identify without patching this package.
+func WithMiddlewareDetector(detector MiddlewareDetector) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.middlewareDetectors = append(p.middlewareDetectors, detector)
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that
+// mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:        zerolog.GlobalLevel(),
+		timeout:         2 * time.Second,
+		indexChunkSize:  500,
+		pubKeyChunkSize: 500,
+	}
+	for _, p := range params {
+		if p != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.address == "" {
+		return nil, errors.New("no address specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// Proposal fetches an unblinded proposal for signing, filling in graffiti
+// from the configured GraffitiProvider if the caller left it unset.
+func (s *Service) Proposal(ctx context.Context, opts *api.ProposalOpts) (*api.Response[*api.VersionedProposal], error) {
+	if opts == nil {
+		return nil, errors.New("no options specified")
+	}
+	if opts.Slot == 0 {
+		return nil, errors.New("no slot specified")
+	}
+	if opts.SkipRandaoVerification && !isPointAtInfinity(opts.RandaoReveal) {
+		return nil, errors.New("randao reveal must be point at infinity if skip randao verification is set")
+	}
+
+	// The proposal endpoint is addressed by slot alone, so the
+	// GraffitiProvider is consulted without a validator index.
+	graffiti, err := s.applyGraffiti(ctx, opts.Slot, 0, opts.Graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("randao_reveal", fmt.Sprintf("%#x", opts.RandaoReveal))
+	if graffiti != ([32]byte{}) {
+		query.Set("graffiti", fmt.Sprintf("%#x", graffiti))
+	}
+	if opts.SkipRandaoVerification {
+		query.Set("skip_randao_verification", "")
+	}
+
+	endpoint := fmt.Sprintf("eth/v1/validator/blocks/%d?%s", opts.Slot, query.Encode())
+
+	httpResponse, err := s.submitRequest(ctx, requestOpts{
+		method:   http.MethodGet,
+		endpoint: endpoint,
+		route:    "eth/v1/validator/blocks/{slot}",
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain proposal"), err)
+	}
+	defer httpResponse.Body.Close()
+
+	var proposal api.VersionedProposal
+	if err := json.NewDecoder(httpResponse.Body).Decode(&proposal); err != nil {
+		return nil, errors.Join(errors.New("failed to parse proposal"), err)
+	}
+
+	return &api.Response[*api.VersionedProposal]{Data: &proposal}, nil
+}
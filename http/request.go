@@ -0,0 +1,104 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// requestOpts carries the per-call parameters for submitRequest.
+type requestOpts struct {
+	method string
+	// endpoint is the actual path (and query string) called, used to build
+	// the request URL.
+	endpoint string
+	// route is a templated form of endpoint (e.g.
+	// "eth/v1/validator/blinded_blocks/{slot}", with any query string
+	// stripped) used as the metric label, so that per-slot/per-request
+	// values in endpoint don't blow up metric cardinality. It defaults to
+	// endpoint if left unset.
+	route       string
+	contentType string
+	body        []byte
+}
+
+// submitRequest issues a single HTTP request to the beacon node on behalf
+// of a provider method, and is the one place through which every such
+// request passes. It merges in the service's headers (static extraHeaders
+// topped up with whatever the configured AuthProvider currently supplies),
+// and records request duration/outcome and response format metrics.
+func (s *Service) submitRequest(ctx context.Context, opts requestOpts) (*http.Response, error) {
+	route := opts.route
+	if route == "" {
+		route = opts.endpoint
+	}
+
+	url := fmt.Sprintf("%s%s", s.base.String(), strings.TrimPrefix(opts.endpoint, "/"))
+
+	var body io.Reader
+	if opts.body != nil {
+		body = bytes.NewReader(opts.body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, opts.method, url, body)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to create request"), err)
+	}
+
+	if opts.contentType != "" {
+		req.Header.Set("Content-Type", opts.contentType)
+	}
+	s.connectionMu.RLock()
+	enforceJSON := s.enforceJSON
+	s.connectionMu.RUnlock()
+	if enforceJSON {
+		req.Header.Set("Accept", "application/json")
+	}
+
+	headers, err := s.authHeaders(ctx)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to obtain request headers"), err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	duration := time.Since(start)
+	if err != nil {
+		s.monitorRequest(route, opts.method, duration, "error")
+
+		return nil, errors.Join(fmt.Errorf("failed to call %s", url), err)
+	}
+
+	s.monitorRequest(route, opts.method, duration, statusClass(resp.StatusCode))
+	s.monitorResponseFormat(strings.Contains(resp.Header.Get("Content-Type"), "octet-stream"))
+
+	return resp, nil
+}
+
+// statusClass buckets an HTTP status code into the coarse class used as a
+// metric label (e.g. "2xx", "4xx"), so the requests_total cardinality
+// doesn't explode with one series per exact status code.
+func statusClass(statusCode int) string {
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
@@ -27,6 +27,7 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/signer"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
@@ -62,12 +63,35 @@ type Service struct {
 	extraHeaders        map[string]string
 
 	// Endpoint support.
-	pingSem                  *semaphore.Weighted
-	connectionMu             sync.RWMutex
-	connectionActive         bool
-	connectionSynced         bool
-	enforceJSON              bool
-	connectedToDVTMiddleware bool
+	pingSem            *semaphore.Weighted
+	connectionMu       sync.RWMutex
+	connectionActive   bool
+	connectionSynced   bool
+	enforceJSON        bool
+	lastSuccessfulPing time.Time
+
+	// metrics holds this Service's Prometheus collectors, or nil if no
+	// metrics.Service was supplied via WithMonitor.
+	metrics *serviceMetrics
+
+	// Middleware detection.
+	middlewareDetectors []MiddlewareDetector
+	middlewareState     middlewareState
+
+	// graffitiProvider supplies proposal graffiti when a caller leaves it
+	// unset in their opts.
+	graffitiProvider GraffitiProvider
+
+	// signer, if configured, lets proposal/attestation flows request a
+	// RANDAO reveal or block signature without the caller holding keys.
+	signer signer.Service
+
+	// authProvider, if configured, supplies headers that can rotate at
+	// runtime (e.g. bearer tokens), merged on top of extraHeaders.
+	authProvider        AuthProvider
+	authHeadersMu       sync.RWMutex
+	authHeadersMerged   map[string]string
+	authHeadersCachedAt time.Time
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -83,8 +107,10 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
+	var metrics *serviceMetrics
 	if parameters.monitor != nil {
-		if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		metrics, err = registerMetrics(ctx, parameters.monitor, parameters.prometheusRegisterer)
+		if err != nil {
 			return nil, errors.Join(errors.New("failed to register metrics"), err)
 		}
 	}
@@ -125,7 +151,12 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 		userPubKeyChunkSize: parameters.pubKeyChunkSize,
 		extraHeaders:        parameters.extraHeaders,
 		enforceJSON:         parameters.enforceJSON,
+		metrics:             metrics,
 		pingSem:             semaphore.NewWeighted(1),
+		graffitiProvider:    parameters.graffitiProvider,
+		signer:              parameters.signer,
+		authProvider:        parameters.authProvider,
+		middlewareDetectors: append([]MiddlewareDetector{charonDetector{}}, parameters.middlewareDetectors...),
 	}
 
 	// Ping the client to see if it is ready to serve requests.
@@ -205,21 +236,20 @@ func (s *Service) clearStaticValues() {
 	s.nodeVersionMutex.Lock()
 	s.nodeVersion = ""
 	s.nodeVersionMutex.Unlock()
+
+	s.monitorStaticRefresh("all")
 }
 
-// checkDVT checks if connected to DVT middleware and sets
-// internal flags appropriately.
+// checkDVT identifies any middleware sitting between this client and the
+// beacon node it ultimately talks to, using the service's configured
+// MiddlewareDetectors.
 func (s *Service) checkDVT(ctx context.Context) error {
 	response, err := s.NodeVersion(ctx, &api.NodeVersionOpts{})
 	if err != nil {
-		return errors.Join(errors.New("failed to obtain node version for DVT check"), err)
+		return errors.Join(errors.New("failed to obtain node version for middleware check"), err)
 	}
 
-	version := strings.ToLower(response.Data)
-
-	if strings.Contains(version, "charon") {
-		s.connectedToDVTMiddleware = true
-	}
+	s.detectMiddleware(ctx, response.Data)
 
 	return nil
 }
@@ -270,6 +300,9 @@ func (s *Service) ping(_ context.Context) {
 		} else {
 			active = true
 			synced = (!response.Data.IsSyncing) || (response.Data.HeadSlot == 0 && response.Data.SyncDistance <= 1)
+			s.connectionMu.Lock()
+			s.lastSuccessfulPing = time.Now()
+			s.connectionMu.Unlock()
 		}
 		s.pingSem.Release(1)
 	}
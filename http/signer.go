@@ -0,0 +1,113 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// domain computes the signature domain for domainType at the given epoch,
+// following the fork in effect at that epoch. It relies on ForkSchedule()
+// and Genesis(), which Service already caches, so domain computation
+// correctly follows the fork schedule across Capella/Deneb/Electra
+// transitions without an extra round trip per signature.
+func (s *Service) domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	var domain phase0.Domain
+
+	genesisResponse, err := s.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return domain, errors.Join(errors.New("failed to obtain genesis for domain computation"), err)
+	}
+
+	forkScheduleResponse, err := s.ForkSchedule(ctx, &api.ForkScheduleOpts{})
+	if err != nil {
+		return domain, errors.Join(errors.New("failed to obtain fork schedule for domain computation"), err)
+	}
+
+	var currentVersion phase0.Version
+	for _, fork := range forkScheduleResponse.Data {
+		if fork.Epoch <= epoch {
+			currentVersion = fork.CurrentVersion
+		}
+	}
+
+	forkDataRoot, err := (&phase0.ForkData{
+		CurrentVersion:        currentVersion,
+		GenesisValidatorsRoot: genesisResponse.Data.GenesisValidatorsRoot,
+	}).HashTreeRoot()
+	if err != nil {
+		return domain, errors.Join(errors.New("failed to compute fork data root"), err)
+	}
+
+	copy(domain[:], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return domain, nil
+}
+
+// ErrNoSigner is returned by the Sign* helpers below when no signer was
+// configured with WithSigner.
+var ErrNoSigner = errors.New("no signer configured")
+
+// RANDAOReveal produces a RANDAO reveal for the given proposer and epoch
+// using the configured remote signer, for a higher-level proposal flow
+// that holds the proposer's public key (the beacon node's proposal
+// endpoints are addressed by slot alone and so cannot make this call
+// themselves).
+func (s *Service) RANDAOReveal(ctx context.Context, pubKey phase0.BLSPubKey, epoch phase0.Epoch) (phase0.BLSSignature, error) {
+	if s.signer == nil {
+		return phase0.BLSSignature{}, ErrNoSigner
+	}
+
+	domain, err := s.domain(ctx, phase0.DomainRandao, epoch)
+	if err != nil {
+		return phase0.BLSSignature{}, errors.Join(errors.New("failed to compute RANDAO domain"), err)
+	}
+
+	return s.signer.SignRANDAOReveal(ctx, pubKey, epoch, domain)
+}
+
+// SignBlockProposal signs the root of an unblinded beacon block using the
+// configured remote signer.
+func (s *Service) SignBlockProposal(ctx context.Context, pubKey phase0.BLSPubKey, epoch phase0.Epoch, blockRoot phase0.Root) (phase0.BLSSignature, error) {
+	if s.signer == nil {
+		return phase0.BLSSignature{}, ErrNoSigner
+	}
+
+	domain, err := s.domain(ctx, phase0.DomainBeaconProposer, epoch)
+	if err != nil {
+		return phase0.BLSSignature{}, errors.Join(errors.New("failed to compute proposer domain"), err)
+	}
+
+	return s.signer.SignBlockProposal(ctx, pubKey, blockRoot, domain)
+}
+
+// SignBlindedBlockProposal signs the root of a blinded beacon block using
+// the configured remote signer.
+func (s *Service) SignBlindedBlockProposal(ctx context.Context, pubKey phase0.BLSPubKey, epoch phase0.Epoch, blockRoot phase0.Root) (phase0.BLSSignature, error) {
+	if s.signer == nil {
+		return phase0.BLSSignature{}, ErrNoSigner
+	}
+
+	domain, err := s.domain(ctx, phase0.DomainBeaconProposer, epoch)
+	if err != nil {
+		return phase0.BLSSignature{}, errors.Join(errors.New("failed to compute proposer domain"), err)
+	}
+
+	return s.signer.SignBlindedBlockProposal(ctx, pubKey, blockRoot, domain)
+}
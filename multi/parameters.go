@@ -0,0 +1,114 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	addresses          []string
+	policy             Policy
+	callTimeout        time.Duration
+	initialBackoff     time.Duration
+	maxBackoff         time.Duration
+	endpointParameters []http.Parameter
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithAddresses sets the addresses of the underlying beacon node endpoints
+// that make up the pool.
+func WithAddresses(addresses []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.addresses = addresses
+	})
+}
+
+// WithPolicy sets the policy used to select between active endpoints for
+// read requests.
+func WithPolicy(policy Policy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.policy = policy
+	})
+}
+
+// WithCallTimeout sets a timeout applied to each individual call made
+// through the pool, layered on top of each endpoint's own timeout.
+func WithCallTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.callTimeout = timeout
+	})
+}
+
+// WithBackoff sets the initial and maximum backoff applied to an endpoint
+// after a failed call, before it is considered eligible again.
+func WithBackoff(initial time.Duration, maximum time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.initialBackoff = initial
+		p.maxBackoff = maximum
+	})
+}
+
+// WithEndpointParameters sets additional parameters passed through to the
+// http.Service created for each endpoint, for example WithTimeout or
+// WithExtraHeaders.
+func WithEndpointParameters(params ...http.Parameter) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.endpointParameters = params
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that
+// mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:       zerolog.GlobalLevel(),
+		policy:         PolicyFirstActive,
+		initialBackoff: 2 * time.Second,
+		maxBackoff:     2 * time.Minute,
+	}
+	for _, p := range params {
+		if p != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.addresses) == 0 {
+		return nil, errors.New("no addresses specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,118 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// Policy defines how the pool selects between its eligible endpoints when
+// serving a read request. Submissions are always broadcast to every
+// eligible endpoint in parallel, returning on the first success.
+type Policy int
+
+const (
+	// PolicyFirstActive selects the first eligible endpoint, in the order
+	// the endpoints were configured.
+	PolicyFirstActive Policy = iota
+	// PolicyBestSyncedHead selects the eligible endpoint that reports the
+	// most advanced synced head slot.
+	PolicyBestSyncedHead
+)
+
+// String provides a human-readable name for the policy.
+func (p Policy) String() string {
+	switch p {
+	case PolicyFirstActive:
+		return "first-active"
+	case PolicyBestSyncedHead:
+		return "best-synced-head"
+	default:
+		return "unknown"
+	}
+}
+
+// selectEndpoint picks an eligible endpoint according to the pool's policy.
+// It returns nil if no endpoint is currently eligible.
+func (s *Service) selectEndpoint(ctx context.Context) *endpoint {
+	switch s.policy {
+	case PolicyBestSyncedHead:
+		return s.selectBestSyncedHead(ctx)
+	case PolicyFirstActive:
+		return s.selectFirstActive(ctx)
+	default:
+		return s.selectFirstActive(ctx)
+	}
+}
+
+func (s *Service) selectFirstActive(ctx context.Context) *endpoint {
+	for _, e := range s.endpoints {
+		if e.eligible(ctx) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) selectBestSyncedHead(ctx context.Context) *endpoint {
+	var best *endpoint
+	var bestSlot uint64
+
+	for _, e := range s.endpoints {
+		if !e.eligible(ctx) {
+			continue
+		}
+
+		syncingProvider, isProvider := e.service.(client.NodeSyncingProvider)
+		if !isProvider {
+			// No way to compare heads for this endpoint; treat it as a
+			// fallback candidate if nothing better is found.
+			if best == nil {
+				best = e
+			}
+
+			continue
+		}
+
+		response, err := syncingProvider.NodeSyncing(ctx, &api.NodeSyncingOpts{})
+		if err != nil {
+			continue
+		}
+
+		slot := uint64(response.Data.HeadSlot)
+		if best == nil || slot > bestSlot {
+			best = e
+			bestSlot = slot
+		}
+	}
+
+	return best
+}
+
+// eligibleEndpoints returns every endpoint currently eligible to serve a
+// request, used by the broadcast-submit path.
+func (s *Service) eligibleEndpoints(ctx context.Context) []*endpoint {
+	eligible := make([]*endpoint, 0, len(s.endpoints))
+	for _, e := range s.endpoints {
+		if e.eligible(ctx) {
+			eligible = append(eligible, e)
+		}
+	}
+
+	return eligible
+}
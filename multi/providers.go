@@ -0,0 +1,186 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// ErrNoEligibleEndpoints is returned when no endpoint in the pool is
+// currently eligible to serve a request.
+var ErrNoEligibleEndpoints = errors.New("no eligible endpoints")
+
+// Genesis provides the genesis information of the chain, served by the
+// endpoint selected by the pool's policy, failing over to the next
+// eligible endpoint if that call errors.
+func (s *Service) Genesis(ctx context.Context, opts *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	ctx, cancel := s.withCallTimeout(ctx)
+	defer cancel()
+
+	return tryEligibleEndpoints(ctx, s, func(ctx context.Context, e *endpoint) (*api.Response[*apiv1.Genesis], error) {
+		return e.service.(client.GenesisProvider).Genesis(ctx, opts)
+	})
+}
+
+// NodeVersion provides the version string of the node served by the
+// endpoint selected by the pool's policy, failing over to the next
+// eligible endpoint if that call errors.
+func (s *Service) NodeVersion(ctx context.Context, opts *api.NodeVersionOpts) (*api.Response[string], error) {
+	ctx, cancel := s.withCallTimeout(ctx)
+	defer cancel()
+
+	return tryEligibleEndpoints(ctx, s, func(ctx context.Context, e *endpoint) (*api.Response[string], error) {
+		return e.service.(client.NodeVersionProvider).NodeVersion(ctx, opts)
+	})
+}
+
+// NodeSyncing provides the sync state of the node served by the endpoint
+// selected by the pool's policy, failing over to the next eligible
+// endpoint if that call errors.
+func (s *Service) NodeSyncing(ctx context.Context, opts *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error) {
+	ctx, cancel := s.withCallTimeout(ctx)
+	defer cancel()
+
+	return tryEligibleEndpoints(ctx, s, func(ctx context.Context, e *endpoint) (*api.Response[*apiv1.SyncState], error) {
+		return e.service.(client.NodeSyncingProvider).NodeSyncing(ctx, opts)
+	})
+}
+
+// tryEligibleEndpoints calls fn against the endpoint chosen by the pool's
+// policy and, if that call errors, goes on to try every other eligible
+// endpoint in turn before giving up, so that a single endpoint failing a
+// read does not fail the whole call. Each endpoint fn fails against is
+// marked failed; the endpoint fn succeeds against is marked succeeded and
+// its result returned. If every eligible endpoint fails, the last error
+// encountered is returned.
+func tryEligibleEndpoints[T any](ctx context.Context, s *Service, fn func(ctx context.Context, e *endpoint) (T, error)) (T, error) {
+	var zero T
+
+	eligible := s.eligibleEndpoints(ctx)
+	if len(eligible) == 0 {
+		return zero, ErrNoEligibleEndpoints
+	}
+
+	ordered := eligible
+	if selected := s.selectEndpoint(ctx); selected != nil {
+		ordered = make([]*endpoint, 0, len(eligible))
+		ordered = append(ordered, selected)
+		for _, e := range eligible {
+			if e != selected {
+				ordered = append(ordered, e)
+			}
+		}
+	}
+
+	var lastErr error
+	for _, e := range ordered {
+		response, err := fn(ctx, e)
+		if err != nil {
+			e.markFailed(s.initialBackoff, s.maxBackoff)
+			lastErr = err
+
+			continue
+		}
+		e.markSucceeded()
+
+		return response, nil
+	}
+
+	return zero, lastErr
+}
+
+// SubmitAttestations submits a set of attestations to every eligible
+// endpoint in parallel, returning as soon as one of them succeeds.
+func (s *Service) SubmitAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) error {
+	ctx, cancel := s.withCallTimeout(ctx)
+	defer cancel()
+
+	return s.broadcastSubmit(ctx, func(ctx context.Context, e *endpoint) error {
+		return e.service.(client.AttestationsSubmitter).SubmitAttestations(ctx, opts)
+	})
+}
+
+// SubmitProposal submits a block proposal to every eligible endpoint in
+// parallel, returning as soon as one of them succeeds.
+func (s *Service) SubmitProposal(ctx context.Context, opts *api.SubmitProposalOpts) error {
+	ctx, cancel := s.withCallTimeout(ctx)
+	defer cancel()
+
+	return s.broadcastSubmit(ctx, func(ctx context.Context, e *endpoint) error {
+		return e.service.(client.ProposalSubmitter).SubmitProposal(ctx, opts)
+	})
+}
+
+// SubmitBlindedProposal submits a blinded block proposal to every eligible
+// endpoint in parallel, returning as soon as one of them succeeds.
+func (s *Service) SubmitBlindedProposal(ctx context.Context, opts *api.SubmitBlindedProposalOpts) error {
+	ctx, cancel := s.withCallTimeout(ctx)
+	defer cancel()
+
+	return s.broadcastSubmit(ctx, func(ctx context.Context, e *endpoint) error {
+		return e.service.(client.BlindedProposalSubmitter).SubmitBlindedProposal(ctx, opts)
+	})
+}
+
+// broadcastSubmit sends submit to every eligible endpoint in parallel and
+// returns as soon as one of them succeeds. Each submission runs against a
+// context detached from ctx's cancellation, bounded instead by its own
+// copy of the pool's call timeout, so that the caller returning (and
+// cancelling ctx via its own deferred cancel) does not abort broadcasts
+// still in flight to the other endpoints. If every endpoint fails, the
+// first error encountered is returned.
+func (s *Service) broadcastSubmit(ctx context.Context, submit func(ctx context.Context, e *endpoint) error) error {
+	endpoints := s.eligibleEndpoints(ctx)
+	if len(endpoints) == 0 {
+		return ErrNoEligibleEndpoints
+	}
+
+	type result struct {
+		err error
+	}
+
+	results := make(chan result, len(endpoints))
+	for _, e := range endpoints {
+		go func(e *endpoint) {
+			submitCtx, cancel := s.withCallTimeout(detachedContext(ctx))
+			defer cancel()
+
+			err := submit(submitCtx, e)
+			if err != nil {
+				e.markFailed(s.initialBackoff, s.maxBackoff)
+			} else {
+				e.markSucceeded()
+			}
+			results <- result{err: err}
+		}(e)
+	}
+
+	var firstErr error
+	for range endpoints {
+		r := <-results
+		if r.err == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = r.err
+		}
+	}
+
+	return firstErr
+}
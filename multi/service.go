@@ -0,0 +1,209 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multi provides a client.Service implementation that pools several
+// underlying HTTP beacon node endpoints behind a single client.Service,
+// selecting between them according to a configurable Policy and failing
+// over when an endpoint is unavailable.
+package multi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// activeSyncedProvider is satisfied by http.Service; it lets the pool reuse
+// the health tracking that each endpoint's own periodic ping already
+// maintains, rather than running a second ping loop against the same node.
+type activeSyncedProvider interface {
+	IsActive(ctx context.Context) bool
+	IsSynced(ctx context.Context) bool
+}
+
+// endpoint wraps a single underlying beacon node connection together with
+// the pool's view of its recent call history.
+type endpoint struct {
+	address string
+	service client.Service
+
+	mu         sync.Mutex
+	retryAfter time.Time
+	backoff    time.Duration
+}
+
+// markFailed puts the endpoint into a backoff window following a failed
+// call, so a flapping node isn't retried on every subsequent request.
+func (e *endpoint) markFailed(initialBackoff, maxBackoff time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.backoff == 0 {
+		e.backoff = initialBackoff
+	} else {
+		e.backoff *= 2
+		if e.backoff > maxBackoff {
+			e.backoff = maxBackoff
+		}
+	}
+	e.retryAfter = time.Now().Add(e.backoff)
+}
+
+// markSucceeded clears any backoff accrued by previous failures.
+func (e *endpoint) markSucceeded() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.backoff = 0
+	e.retryAfter = time.Time{}
+}
+
+// eligible returns true if the endpoint is active and outside its backoff
+// window.
+func (e *endpoint) eligible(ctx context.Context) bool {
+	provider, isProvider := e.service.(activeSyncedProvider)
+	if isProvider && !provider.IsActive(ctx) {
+		return false
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return time.Now().After(e.retryAfter)
+}
+
+// Service is a client.Service that pools multiple underlying HTTP beacon
+// node endpoints. It currently implements client.GenesisProvider,
+// client.NodeVersionProvider, client.NodeSyncingProvider,
+// client.AttestationsSubmitter, client.ProposalSubmitter and
+// client.BlindedProposalSubmitter; it is not yet a drop-in replacement for
+// every client.Service provider interface.
+type Service struct {
+	log zerolog.Logger
+
+	policy         Policy
+	callTimeout    time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	endpoints []*endpoint
+}
+
+// New creates a new multi-endpoint client.Service, connecting to each of the
+// supplied addresses with a standard HTTP service.
+func New(ctx context.Context, params ...Parameter) (client.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	log := zerologger.With().Str("service", "client").Str("impl", "multi").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:            log,
+		policy:         parameters.policy,
+		callTimeout:    parameters.callTimeout,
+		initialBackoff: parameters.initialBackoff,
+		maxBackoff:     parameters.maxBackoff,
+	}
+
+	for _, address := range parameters.addresses {
+		endpointParams := append([]http.Parameter{http.WithAddress(address)}, parameters.endpointParameters...)
+
+		httpService, err := http.New(ctx, endpointParams...)
+		if err != nil {
+			log.Warn().Str("address", address).Err(err).Msg("Failed to create endpoint; continuing without it")
+
+			continue
+		}
+
+		s.endpoints = append(s.endpoints, &endpoint{
+			address: address,
+			service: httpService,
+		})
+	}
+
+	if len(s.endpoints) == 0 {
+		return nil, errors.New("no endpoints could be created")
+	}
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "Multi-endpoint pool"
+}
+
+// Address provides the addresses for the connection.
+func (s *Service) Address() string {
+	addresses := make([]string, 0, len(s.endpoints))
+	for _, e := range s.endpoints {
+		addresses = append(addresses, e.address)
+	}
+
+	return fmt.Sprintf("%v", addresses)
+}
+
+// IsActive returns true if at least one endpoint in the pool is active and
+// outside its backoff window.
+func (s *Service) IsActive(ctx context.Context) bool {
+	for _, e := range s.endpoints {
+		if e.eligible(ctx) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSynced returns true if at least one endpoint in the pool is synced,
+// active and outside its backoff window.
+func (s *Service) IsSynced(ctx context.Context) bool {
+	for _, e := range s.endpoints {
+		provider, isProvider := e.service.(activeSyncedProvider)
+		if e.eligible(ctx) && isProvider && provider.IsSynced(ctx) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withCallTimeout returns a context bounded by the pool's configured
+// per-call timeout, layered on top of whatever timeout the caller already
+// set on ctx.
+func (s *Service) withCallTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.callTimeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, s.callTimeout)
+}
+
+// detachedContext returns a copy of ctx that carries its values but is not
+// cancelled when ctx is, for work that must continue after the call that
+// created ctx has returned (and cancelled it via a deferred cancel).
+func detachedContext(ctx context.Context) context.Context {
+	return context.WithoutCancel(ctx)
+}
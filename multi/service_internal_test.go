@@ -0,0 +1,91 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointBackoffAndEligibility(t *testing.T) {
+	ctx := context.Background()
+	e := &endpoint{address: "endpoint-1"}
+
+	require.True(t, e.eligible(ctx))
+
+	e.markFailed(10*time.Millisecond, time.Second)
+	require.False(t, e.eligible(ctx))
+
+	time.Sleep(20 * time.Millisecond)
+	require.True(t, e.eligible(ctx))
+
+	// A second failure doubles the backoff rather than resetting it.
+	e.markFailed(10*time.Millisecond, time.Second)
+	firstBackoff := e.backoff
+	e.markFailed(10*time.Millisecond, time.Second)
+	require.Equal(t, firstBackoff*2, e.backoff)
+
+	e.markSucceeded()
+	require.True(t, e.eligible(ctx))
+	require.Zero(t, e.backoff)
+}
+
+func TestBroadcastSubmitFirstSuccess(t *testing.T) {
+	s := &Service{
+		initialBackoff: time.Second,
+		maxBackoff:     time.Minute,
+		endpoints: []*endpoint{
+			{address: "endpoint-1"},
+			{address: "endpoint-2"},
+		},
+	}
+
+	err := s.broadcastSubmit(context.Background(), func(_ context.Context, e *endpoint) error {
+		if e.address == "endpoint-2" {
+			return errors.New("submit failed")
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestBroadcastSubmitAllFail(t *testing.T) {
+	s := &Service{
+		initialBackoff: time.Second,
+		maxBackoff:     time.Minute,
+		endpoints: []*endpoint{
+			{address: "endpoint-1"},
+			{address: "endpoint-2"},
+		},
+	}
+
+	err := s.broadcastSubmit(context.Background(), func(_ context.Context, _ *endpoint) error {
+		return errors.New("submit failed")
+	})
+	require.ErrorContains(t, err, "submit failed")
+}
+
+func TestBroadcastSubmitNoEligibleEndpoints(t *testing.T) {
+	s := &Service{}
+
+	err := s.broadcastSubmit(context.Background(), func(_ context.Context, _ *endpoint) error {
+		return nil
+	})
+	require.ErrorIs(t, err, ErrNoEligibleEndpoints)
+}
@@ -0,0 +1,133 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signer defines the interfaces used to delegate the production of
+// validator signatures to a remote signer such as Dirk or Web3Signer,
+// keeping key material out of process.
+//
+// Every method takes the SSZ hash tree root of the object being signed
+// rather than the object itself: the caller already has to compute that
+// root to request this signature in the first place (for example to fill
+// in a proposal's parent data), and keeping it out of these interfaces
+// means implementations do not need to understand every spec container
+// across Capella/Deneb/Electra, only how to wrap a root with a domain.
+package signer
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Service is the full set of signing capabilities a remote signer may
+// provide. Implementations need not support every capability; callers
+// should use the individual interfaces below to type-assert the
+// capabilities they need.
+type Service interface {
+	AttestationSigner
+	BlockSigner
+	BlindedBlockSigner
+	VoluntaryExitSigner
+	AggregateAndProofSigner
+	SyncCommitteeSigner
+	ValidatorRegistrationSigner
+}
+
+// AttestationSigner signs attestation data on behalf of a validator.
+type AttestationSigner interface {
+	// SignAttestation signs the hash tree root of the given attestation
+	// data for the validator identified by pubKey, for the given
+	// signature domain.
+	SignAttestation(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		attestationDataRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+}
+
+// BlockSigner signs unblinded beacon block proposals on behalf of a
+// validator, and provides RANDAO reveals for the slot being proposed.
+type BlockSigner interface {
+	// SignRANDAOReveal signs the epoch of the slot being proposed.
+	SignRANDAOReveal(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		epoch phase0.Epoch,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+	// SignBlockProposal signs the hash tree root of an unblinded beacon
+	// block.
+	SignBlockProposal(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		blockRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+}
+
+// BlindedBlockSigner signs blinded beacon block proposals on behalf of a
+// validator.
+type BlindedBlockSigner interface {
+	SignBlindedBlockProposal(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		blockRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+}
+
+// VoluntaryExitSigner signs voluntary exits on behalf of a validator.
+type VoluntaryExitSigner interface {
+	SignVoluntaryExit(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		exitRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+}
+
+// AggregateAndProofSigner signs aggregate and proofs on behalf of a
+// validator.
+type AggregateAndProofSigner interface {
+	SignAggregateAndProof(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		aggregateAndProofRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+}
+
+// SyncCommitteeSigner signs sync committee messages and selection proofs on
+// behalf of a validator.
+type SyncCommitteeSigner interface {
+	SignSyncCommitteeMessage(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		beaconBlockRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+	SignSyncCommitteeSelectionProof(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		selectionDataRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+	SignContributionAndProof(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		contributionAndProofRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+}
+
+// ValidatorRegistrationSigner signs builder validator registrations on
+// behalf of a validator.
+type ValidatorRegistrationSigner interface {
+	SignValidatorRegistration(ctx context.Context,
+		pubKey phase0.BLSPubKey,
+		registrationRoot phase0.Root,
+		domain phase0.Domain,
+	) (phase0.BLSSignature, error)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package web3signer provides a signer.Service that delegates validator
+// signing operations to a remote Web3Signer (or Dirk instance fronted by
+// the Web3Signer-compatible API) over HTTP.
+package web3signer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/signer"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a signer.Service that talks to a Web3Signer instance.
+type Service struct {
+	log     zerolog.Logger
+	address string
+	client  *http.Client
+}
+
+// Confirm that Service satisfies the signer.Service interface.
+var _ signer.Service = (*Service)(nil)
+
+// New creates a new Web3Signer signing service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Join(errors.New("problem with parameters"), err)
+	}
+
+	log := zerologger.With().Str("service", "signer").Str("impl", "web3signer").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	address := parameters.address
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+
+	s := &Service{
+		log:     log,
+		address: address,
+		client:  &http.Client{Timeout: parameters.timeout},
+	}
+
+	return s, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "Web3Signer"
+}
+
+// Address provides the address of the Web3Signer instance.
+func (s *Service) Address() string {
+	return s.address
+}
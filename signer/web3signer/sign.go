@@ -0,0 +1,227 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// signRequest is the JSON request body sent to Web3Signer's
+// /api/v1/eth2/sign/{identifier} endpoint. Web3Signer's documented schema
+// additionally accepts fork_info plus a type-specific payload (e.g.
+// beacon_block, attestation) alongside signingRoot, and some deployments
+// reject a signingRoot-only body; this client only ever supplies
+// signingRoot, since callers only hold an already-computed object root
+// and not the full typed payload. Pointed at a Web3Signer that requires
+// the typed payload, sign requests will fail.
+type signRequest struct {
+	Type        string `json:"type"`
+	SigningRoot string `json:"signingRoot"`
+}
+
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// signingRoot wraps an object root with a signature domain, per the
+// SSZ SigningData container, and returns it hex-encoded for Web3Signer.
+func signingRoot(objectRoot phase0.Root, domain phase0.Domain) (string, error) {
+	signingData := phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return "", errors.Join(errors.New("failed to compute signing root"), err)
+	}
+
+	return fmt.Sprintf("%#x", root), nil
+}
+
+// sign sends a sign request of the given type to Web3Signer for the given
+// validator public key and decodes the returned BLS signature.
+func (s *Service) sign(ctx context.Context, pubKey phase0.BLSPubKey, requestType string, objectRoot phase0.Root, domain phase0.Domain) (phase0.BLSSignature, error) {
+	var signature phase0.BLSSignature
+
+	root, err := signingRoot(objectRoot, domain)
+	if err != nil {
+		return signature, err
+	}
+
+	body, err := json.Marshal(&signRequest{
+		Type:        requestType,
+		SigningRoot: root,
+	})
+	if err != nil {
+		return signature, errors.Join(errors.New("failed to marshal sign request"), err)
+	}
+
+	identifier := fmt.Sprintf("%#x", pubKey)
+	url := fmt.Sprintf("%sapi/v1/eth2/sign/%s", s.address, identifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return signature, errors.Join(errors.New("failed to create sign request"), err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return signature, errors.Join(errors.New("failed to call web3signer"), err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return signature, errors.Join(errors.New("failed to read web3signer response"), err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return signature, fmt.Errorf("web3signer returned status %d: %s", resp.StatusCode, string(data))
+	}
+
+	var response signResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return signature, errors.Join(errors.New("failed to unmarshal web3signer response"), err)
+	}
+
+	sigBytes, err := hex.DecodeString(strings.TrimPrefix(response.Signature, "0x"))
+	if err != nil {
+		return signature, errors.Join(errors.New("invalid signature returned by web3signer"), err)
+	}
+	copy(signature[:], sigBytes)
+
+	return signature, nil
+}
+
+// SignAttestation signs the hash tree root of attestation data for the
+// given validator.
+func (s *Service) SignAttestation(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	attestationDataRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "ATTESTATION", attestationDataRoot, domain)
+}
+
+// SignRANDAOReveal signs the epoch of the slot being proposed.
+func (s *Service) SignRANDAOReveal(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	epoch phase0.Epoch,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	var root phase0.Root
+	copy(root[:8], epochToBytes(epoch))
+
+	return s.sign(ctx, pubKey, "RANDAO_REVEAL", root, domain)
+}
+
+// SignBlockProposal signs the hash tree root of an unblinded beacon block.
+func (s *Service) SignBlockProposal(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	blockRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "BLOCK_V2", blockRoot, domain)
+}
+
+// SignBlindedBlockProposal signs the hash tree root of a blinded beacon
+// block.
+func (s *Service) SignBlindedBlockProposal(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	blockRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "BLOCK_V2", blockRoot, domain)
+}
+
+// SignVoluntaryExit signs the hash tree root of a voluntary exit.
+func (s *Service) SignVoluntaryExit(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	exitRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "VOLUNTARY_EXIT", exitRoot, domain)
+}
+
+// SignAggregateAndProof signs the hash tree root of an aggregate and proof.
+func (s *Service) SignAggregateAndProof(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	aggregateAndProofRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "AGGREGATE_AND_PROOF", aggregateAndProofRoot, domain)
+}
+
+// SignSyncCommitteeMessage signs a sync committee message.
+func (s *Service) SignSyncCommitteeMessage(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	beaconBlockRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "SYNC_COMMITTEE_MESSAGE", beaconBlockRoot, domain)
+}
+
+// SignSyncCommitteeSelectionProof signs the hash tree root of a sync
+// committee selection proof.
+func (s *Service) SignSyncCommitteeSelectionProof(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	selectionDataRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "SYNC_COMMITTEE_SELECTION_PROOF", selectionDataRoot, domain)
+}
+
+// SignContributionAndProof signs the hash tree root of a sync committee
+// contribution and proof.
+func (s *Service) SignContributionAndProof(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	contributionAndProofRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF", contributionAndProofRoot, domain)
+}
+
+// SignValidatorRegistration signs the hash tree root of a builder validator
+// registration.
+func (s *Service) SignValidatorRegistration(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	registrationRoot phase0.Root,
+	domain phase0.Domain,
+) (phase0.BLSSignature, error) {
+	return s.sign(ctx, pubKey, "VALIDATOR_REGISTRATION", registrationRoot, domain)
+}
+
+// epochToBytes encodes an epoch as a little-endian uint64, matching the SSZ
+// encoding of a basic uint64 value.
+func epochToBytes(epoch phase0.Epoch) []byte {
+	b := make([]byte, 8)
+	for i := range b {
+		b[i] = byte(epoch >> (8 * uint(i)))
+	}
+
+	return b
+}
@@ -0,0 +1,59 @@
+// Copyright © 2024 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web3signer
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochToBytes(t *testing.T) {
+	tests := []struct {
+		epoch    phase0.Epoch
+		expected []byte
+	}{
+		{epoch: 0, expected: []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+		{epoch: 1, expected: []byte{1, 0, 0, 0, 0, 0, 0, 0}},
+		{epoch: 256, expected: []byte{0, 1, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, epochToBytes(test.epoch))
+	}
+}
+
+func TestSigningRoot(t *testing.T) {
+	var objectRoot phase0.Root
+	copy(objectRoot[:], []byte{0x01, 0x02, 0x03})
+	var domain phase0.Domain
+	copy(domain[:], []byte{0x04, 0x05, 0x06})
+
+	root, err := signingRoot(objectRoot, domain)
+	require.NoError(t, err)
+	require.Len(t, root, 2+32*2)
+
+	// Deterministic: the same inputs produce the same signing root.
+	again, err := signingRoot(objectRoot, domain)
+	require.NoError(t, err)
+	require.Equal(t, root, again)
+
+	// The domain is mixed in: changing it changes the signing root.
+	var otherDomain phase0.Domain
+	copy(otherDomain[:], []byte{0x07, 0x08, 0x09})
+	differentDomainRoot, err := signingRoot(objectRoot, otherDomain)
+	require.NoError(t, err)
+	require.NotEqual(t, root, differentDomainRoot)
+}